@@ -0,0 +1,36 @@
+// Package chainofresponsibility реализует паттерн "Цепочка обязанностей"
+// (Chain of Responsibility).
+//
+// Запрос передаётся по цепочке обработчиков, пока кто-нибудь из них его не
+// обработает.
+package chainofresponsibility
+
+// Kid — звено цепочки: либо сам хочет яблоко, либо передаёт вопрос дальше.
+type Kid struct {
+	Name    string
+	wantsIt bool
+	next    *Kid
+}
+
+// NewKid создаёт ребёнка. wantsIt определяет, остановится ли на нём цепочка.
+func NewKid(name string, wantsIt bool) *Kid {
+	return &Kid{Name: name, wantsIt: wantsIt}
+}
+
+// SetNext задаёт следующее звено цепочки.
+func (k *Kid) SetNext(next *Kid) {
+	k.next = next
+}
+
+// AskForApple спрашивает детей по цепочке, пока кто-нибудь не согласится
+// взять яблоко, и возвращает его имя. Если никто не согласился, возвращает
+// пустую строку.
+func (k *Kid) AskForApple() string {
+	if k.wantsIt {
+		return k.Name
+	}
+	if k.next != nil {
+		return k.next.AskForApple()
+	}
+	return ""
+}
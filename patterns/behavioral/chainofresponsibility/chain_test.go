@@ -0,0 +1,66 @@
+package chainofresponsibility_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/chainofresponsibility"
+)
+
+func TestAskForApple(t *testing.T) {
+	tests := []struct {
+		name  string
+		chain func() *chainofresponsibility.Kid
+		want  string
+	}{
+		{
+			name: "last kid wants it",
+			chain: func() *chainofresponsibility.Kid {
+				vasya := chainofresponsibility.NewKid("Вася", false)
+				petya := chainofresponsibility.NewKid("Петя", false)
+				masha := chainofresponsibility.NewKid("Маша", true)
+				vasya.SetNext(petya)
+				petya.SetNext(masha)
+				return vasya
+			},
+			want: "Маша",
+		},
+		{
+			name: "first kid wants it",
+			chain: func() *chainofresponsibility.Kid {
+				return chainofresponsibility.NewKid("Вася", true)
+			},
+			want: "Вася",
+		},
+		{
+			name: "nobody wants it",
+			chain: func() *chainofresponsibility.Kid {
+				vasya := chainofresponsibility.NewKid("Вася", false)
+				petya := chainofresponsibility.NewKid("Петя", false)
+				vasya.SetNext(petya)
+				return vasya
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.chain().AskForApple(); got != tt.want {
+				t.Errorf("AskForApple() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleKid_AskForApple() {
+	vasya := chainofresponsibility.NewKid("Вася", false)
+	petya := chainofresponsibility.NewKid("Петя", false)
+	masha := chainofresponsibility.NewKid("Маша", true)
+	vasya.SetNext(petya)
+	petya.SetNext(masha)
+
+	fmt.Println(vasya.AskForApple(), "хочет яблоко")
+	// Output:
+	// Маша хочет яблоко
+}
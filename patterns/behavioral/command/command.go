@@ -0,0 +1,37 @@
+// Package command реализует паттерн "Команда" (Command).
+//
+// Упаковывает действие в объект, который можно выполнить сразу или передать
+// для выполнения позже.
+package command
+
+import "fmt"
+
+// Command — действие, которое можно выполнить.
+type Command interface {
+	Execute() string
+}
+
+// EatAppleCommand — команда "съесть яблоко".
+type EatAppleCommand struct {
+	Name string
+}
+
+// Execute реализует Command.
+func (e *EatAppleCommand) Execute() string {
+	return fmt.Sprintf("%s съел яблоко!", e.Name)
+}
+
+// Kid выполняет назначенную ему команду.
+type Kid struct {
+	command Command
+}
+
+// SetCommand назначает команду для выполнения.
+func (k *Kid) SetCommand(c Command) {
+	k.command = c
+}
+
+// DoCommand выполняет назначенную команду и возвращает её результат.
+func (k *Kid) DoCommand() string {
+	return k.command.Execute()
+}
@@ -0,0 +1,26 @@
+package command_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/command"
+)
+
+func TestKidDoCommand(t *testing.T) {
+	kid := &command.Kid{}
+	kid.SetCommand(&command.EatAppleCommand{Name: "Вася"})
+
+	if got, want := kid.DoCommand(), "Вася съел яблоко!"; got != want {
+		t.Errorf("DoCommand() = %q, want %q", got, want)
+	}
+}
+
+func ExampleKid_DoCommand() {
+	kid := &command.Kid{}
+	kid.SetCommand(&command.EatAppleCommand{Name: "Вася"})
+
+	fmt.Println(kid.DoCommand())
+	// Output:
+	// Вася съел яблоко!
+}
@@ -0,0 +1,46 @@
+// Package interpreter реализует паттерн "Интерпретатор" (Interpreter).
+//
+// Проблема: нужно многократно проверять принадлежность строки простому
+// языку (например, булевым выражениям над множеством имён), не разбирая
+// каждый раз правило вручную. Решение: каждое правило грамматики — узел
+// дерева выражений со своим методом Interpret; сложные правила строятся
+// композицией простых.
+package interpreter
+
+import "strings"
+
+// Expression — узел дерева разбора, умеющий интерпретировать контекст.
+type Expression interface {
+	Interpret(context string) bool
+}
+
+// TerminalExpression — терминальный символ грамматики: проверяет, что
+// context содержит заданное слово.
+type TerminalExpression struct {
+	Data string
+}
+
+// Interpret реализует Expression.
+func (t *TerminalExpression) Interpret(context string) bool {
+	return strings.Contains(context, t.Data)
+}
+
+// OrExpression — логическое "ИЛИ" двух выражений.
+type OrExpression struct {
+	Left, Right Expression
+}
+
+// Interpret реализует Expression.
+func (o *OrExpression) Interpret(context string) bool {
+	return o.Left.Interpret(context) || o.Right.Interpret(context)
+}
+
+// AndExpression — логическое "И" двух выражений.
+type AndExpression struct {
+	Left, Right Expression
+}
+
+// Interpret реализует Expression.
+func (a *AndExpression) Interpret(context string) bool {
+	return a.Left.Interpret(context) && a.Right.Interpret(context)
+}
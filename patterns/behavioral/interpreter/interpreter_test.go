@@ -0,0 +1,55 @@
+package interpreter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/interpreter"
+)
+
+// isMan выражает правило "Иван" ИЛИ "Пётр", isMarried выражает "женат".
+func isMan() interpreter.Expression {
+	return &interpreter.OrExpression{
+		Left:  &interpreter.TerminalExpression{Data: "Иван"},
+		Right: &interpreter.TerminalExpression{Data: "Пётр"},
+	}
+}
+
+func isMarriedMan() interpreter.Expression {
+	return &interpreter.AndExpression{
+		Left:  isMan(),
+		Right: &interpreter.TerminalExpression{Data: "женат"},
+	}
+}
+
+func TestExpressions(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    interpreter.Expression
+		context string
+		want    bool
+	}{
+		{name: "or matches left", expr: isMan(), context: "Иван", want: true},
+		{name: "or matches right", expr: isMan(), context: "Пётр", want: true},
+		{name: "or matches neither", expr: isMan(), context: "Мария", want: false},
+		{name: "and both true", expr: isMarriedMan(), context: "Иван женат", want: true},
+		{name: "and one false", expr: isMarriedMan(), context: "Иван холост", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.expr.Interpret(tt.context); got != tt.want {
+				t.Errorf("Interpret(%q) = %v, want %v", tt.context, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleAndExpression() {
+	expr := isMarriedMan()
+	fmt.Println(expr.Interpret("Иван женат"))
+	fmt.Println(expr.Interpret("Иван холост"))
+	// Output:
+	// true
+	// false
+}
@@ -0,0 +1,50 @@
+// Package iterator реализует паттерн "Итератор" (Iterator).
+//
+// Проблема: клиенту нужно перебирать элементы коллекции, не зная о её
+// внутреннем устройстве (слайс, дерево, связный список). Решение: общий
+// интерфейс обхода, не раскрывающий внутреннее представление коллекции.
+package iterator
+
+// Iterator последовательно перебирает элементы коллекции.
+type Iterator[T any] interface {
+	HasNext() bool
+	Next() T
+}
+
+// Collection — коллекция, по которой можно создать Iterator.
+type Collection[T any] interface {
+	Iterator() Iterator[T]
+}
+
+// SliceCollection — коллекция поверх обычного слайса.
+type SliceCollection[T any] struct {
+	items []T
+}
+
+// NewSliceCollection создаёт коллекцию над заданными элементами.
+func NewSliceCollection[T any](items []T) *SliceCollection[T] {
+	return &SliceCollection[T]{items: items}
+}
+
+// Iterator реализует Collection, возвращая независимый итератор с
+// собственной позицией.
+func (c *SliceCollection[T]) Iterator() Iterator[T] {
+	return &sliceIterator[T]{items: c.items}
+}
+
+type sliceIterator[T any] struct {
+	items []T
+	pos   int
+}
+
+// HasNext реализует Iterator.
+func (it *sliceIterator[T]) HasNext() bool {
+	return it.pos < len(it.items)
+}
+
+// Next реализует Iterator.
+func (it *sliceIterator[T]) Next() T {
+	item := it.items[it.pos]
+	it.pos++
+	return item
+}
@@ -0,0 +1,53 @@
+package iterator_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/iterator"
+)
+
+func TestSliceCollectionIterator(t *testing.T) {
+	collection := iterator.NewSliceCollection([]string{"a", "b", "c"})
+
+	var got []string
+	it := collection.Iterator()
+	for it.HasNext() {
+		got = append(got, it.Next())
+	}
+
+	want := []string{"a", "b", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("collected %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestIteratorsAreIndependent(t *testing.T) {
+	collection := iterator.NewSliceCollection([]int{1, 2, 3})
+
+	first := collection.Iterator()
+	first.Next()
+
+	second := collection.Iterator()
+	if got := second.Next(); got != 1 {
+		t.Errorf("second.Next() = %d, want 1 (iterators should not share position)", got)
+	}
+}
+
+func ExampleSliceCollection() {
+	collection := iterator.NewSliceCollection([]string{"a", "b", "c"})
+
+	it := collection.Iterator()
+	for it.HasNext() {
+		fmt.Println(it.Next())
+	}
+	// Output:
+	// a
+	// b
+	// c
+}
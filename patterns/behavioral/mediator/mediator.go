@@ -0,0 +1,67 @@
+// Package mediator реализует паттерн "Посредник" (Mediator).
+//
+// Проблема: компоненты системы (виджеты диалога) напрямую ссылаются друг на
+// друга, и граф этих ссылок быстро превращается в "спагетти". Решение:
+// компоненты знают только о посреднике и сообщают ему о своих событиях, а
+// посредник решает, кого и как оповестить.
+package mediator
+
+// Mediator координирует взаимодействие компонентов.
+type Mediator interface {
+	Notify(sender Component, event string)
+}
+
+// Component — участник диалога, знающий только о своём посреднике.
+type Component interface {
+	SetMediator(m Mediator)
+}
+
+// Checkbox — флажок "Подписаться на рассылку".
+type Checkbox struct {
+	mediator Mediator
+	Checked  bool
+}
+
+// SetMediator реализует Component.
+func (c *Checkbox) SetMediator(m Mediator) {
+	c.mediator = m
+}
+
+// Toggle переключает флажок и уведомляет посредника.
+func (c *Checkbox) Toggle() {
+	c.Checked = !c.Checked
+	c.mediator.Notify(c, "toggled")
+}
+
+// Button — кнопка "Отправить", доступная только если флажок отмечен.
+type Button struct {
+	mediator Mediator
+	Enabled  bool
+}
+
+// SetMediator реализует Component.
+func (b *Button) SetMediator(m Mediator) {
+	b.mediator = m
+}
+
+// DialogMediator — конкретный посредник: включает кнопку, только когда
+// флажок отмечен.
+type DialogMediator struct {
+	Checkbox *Checkbox
+	Button   *Button
+}
+
+// NewDialogMediator связывает флажок и кнопку через посредника.
+func NewDialogMediator(checkbox *Checkbox, button *Button) *DialogMediator {
+	m := &DialogMediator{Checkbox: checkbox, Button: button}
+	checkbox.SetMediator(m)
+	button.SetMediator(m)
+	return m
+}
+
+// Notify реализует Mediator.
+func (m *DialogMediator) Notify(sender Component, event string) {
+	if sender == m.Checkbox && event == "toggled" {
+		m.Button.Enabled = m.Checkbox.Checked
+	}
+}
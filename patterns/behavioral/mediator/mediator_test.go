@@ -0,0 +1,39 @@
+package mediator_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/mediator"
+)
+
+func TestDialogMediatorEnablesButton(t *testing.T) {
+	checkbox := &mediator.Checkbox{}
+	button := &mediator.Button{}
+	mediator.NewDialogMediator(checkbox, button)
+
+	if button.Enabled {
+		t.Fatal("button.Enabled = true before checkbox is toggled")
+	}
+
+	checkbox.Toggle()
+	if !button.Enabled {
+		t.Error("button.Enabled = false after checkbox was checked")
+	}
+
+	checkbox.Toggle()
+	if button.Enabled {
+		t.Error("button.Enabled = true after checkbox was unchecked")
+	}
+}
+
+func ExampleDialogMediator() {
+	checkbox := &mediator.Checkbox{}
+	button := &mediator.Button{}
+	mediator.NewDialogMediator(checkbox, button)
+
+	checkbox.Toggle()
+	fmt.Println(button.Enabled)
+	// Output:
+	// true
+}
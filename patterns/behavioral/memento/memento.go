@@ -0,0 +1,60 @@
+// Package memento реализует паттерн "Снимок" (Memento).
+//
+// Проблема: нужен откат объекта к предыдущему состоянию (undo), но без
+// нарушения инкапсуляции — внешний код не должен знать о внутренних полях
+// объекта. Решение: сам объект умеет делать свой снимок (Memento) и
+// восстанавливаться из него; хранением истории снимков занимается отдельный
+// caretaker, который в сам снимок не заглядывает.
+package memento
+
+// Memento — непрозрачный снимок состояния Editor. Поля не экспортируются,
+// поэтому caretaker не может ни прочитать, ни изменить их.
+type Memento struct {
+	text string
+}
+
+// Editor — редактор текста с возможностью отката.
+type Editor struct {
+	text string
+}
+
+// Type вставляет текст в конец документа.
+func (e *Editor) Type(text string) {
+	e.text += text
+}
+
+// Text возвращает текущее содержимое документа.
+func (e *Editor) Text() string {
+	return e.text
+}
+
+// Save делает снимок текущего состояния.
+func (e *Editor) Save() *Memento {
+	return &Memento{text: e.text}
+}
+
+// Restore возвращает документ к состоянию, зафиксированному в Memento.
+func (e *Editor) Restore(m *Memento) {
+	e.text = m.text
+}
+
+// History хранит снимки Editor и умеет откатываться к последнему из них.
+type History struct {
+	snapshots []*Memento
+}
+
+// Push добавляет снимок в историю.
+func (h *History) Push(m *Memento) {
+	h.snapshots = append(h.snapshots, m)
+}
+
+// Pop извлекает последний снимок из истории. Второе возвращаемое значение
+// равно false, если история пуста.
+func (h *History) Pop() (*Memento, bool) {
+	if len(h.snapshots) == 0 {
+		return nil, false
+	}
+	last := h.snapshots[len(h.snapshots)-1]
+	h.snapshots = h.snapshots[:len(h.snapshots)-1]
+	return last, true
+}
@@ -0,0 +1,54 @@
+package memento_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/memento"
+)
+
+func TestEditorUndo(t *testing.T) {
+	editor := &memento.Editor{}
+	history := &memento.History{}
+
+	editor.Type("Привет")
+	history.Push(editor.Save())
+
+	editor.Type(", мир!")
+	if got, want := editor.Text(), "Привет, мир!"; got != want {
+		t.Fatalf("Text() = %q, want %q", got, want)
+	}
+
+	snapshot, ok := history.Pop()
+	if !ok {
+		t.Fatal("Pop() = false, want a snapshot")
+	}
+	editor.Restore(snapshot)
+
+	if got, want := editor.Text(), "Привет"; got != want {
+		t.Errorf("after Restore(): Text() = %q, want %q", got, want)
+	}
+}
+
+func TestHistoryPopEmpty(t *testing.T) {
+	history := &memento.History{}
+	if _, ok := history.Pop(); ok {
+		t.Error("Pop() on empty history = true, want false")
+	}
+}
+
+func ExampleEditor_Restore() {
+	editor := &memento.Editor{}
+	history := &memento.History{}
+
+	editor.Type("Привет")
+	history.Push(editor.Save())
+	editor.Type(", мир!")
+
+	snapshot, _ := history.Pop()
+	editor.Restore(snapshot)
+
+	fmt.Println(editor.Text())
+	// Output:
+	// Привет
+}
@@ -0,0 +1,49 @@
+// Package observer реализует паттерн "Наблюдатель" (Observer).
+//
+// NewsAgency уведомляет зарегистрированных подписчиков о новостях.
+package observer
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Subscriber получает уведомления от NewsAgency.
+type Subscriber interface {
+	Notify(message string)
+}
+
+// NewsAgency хранит список подписчиков и рассылает им сообщения.
+//
+// Register и Broadcast защищены мьютексом, поэтому агентство можно
+// безопасно использовать из нескольких горутин одновременно.
+type NewsAgency struct {
+	mu          sync.RWMutex
+	subscribers []Subscriber
+}
+
+// Register добавляет подписчика в список рассылки.
+func (n *NewsAgency) Register(subscriber Subscriber) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.subscribers = append(n.subscribers, subscriber)
+}
+
+// Broadcast рассылает сообщение всем подписчикам.
+func (n *NewsAgency) Broadcast(message string) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for _, subscriber := range n.subscribers {
+		subscriber.Notify(message)
+	}
+}
+
+// User — подписчик, печатающий полученные новости со своим именем.
+type User struct {
+	Name string
+}
+
+// Notify реализует Subscriber.
+func (u *User) Notify(message string) {
+	fmt.Printf("Пользователь %s получил новость: %s\n", u.Name, message)
+}
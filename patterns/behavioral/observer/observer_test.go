@@ -0,0 +1,65 @@
+package observer_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/observer"
+)
+
+type recordingSubscriber struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (r *recordingSubscriber) Notify(message string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, message)
+}
+
+func TestNewsAgencyBroadcast(t *testing.T) {
+	agency := &observer.NewsAgency{}
+	sub1 := &recordingSubscriber{}
+	sub2 := &recordingSubscriber{}
+
+	agency.Register(sub1)
+	agency.Register(sub2)
+	agency.Broadcast("Go 2.0 вышел!")
+
+	for i, sub := range []*recordingSubscriber{sub1, sub2} {
+		if len(sub.messages) != 1 || sub.messages[0] != "Go 2.0 вышел!" {
+			t.Errorf("subscriber %d got %v, want [Go 2.0 вышел!]", i, sub.messages)
+		}
+	}
+}
+
+func TestNewsAgencyConcurrentRegisterAndBroadcast(t *testing.T) {
+	agency := &observer.NewsAgency{}
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			agency.Register(&recordingSubscriber{})
+		}()
+		go func() {
+			defer wg.Done()
+			agency.Broadcast("обновление")
+		}()
+	}
+	wg.Wait()
+}
+
+func ExampleNewsAgency() {
+	agency := &observer.NewsAgency{}
+
+	agency.Register(&observer.User{Name: "Алексей"})
+	agency.Register(&observer.User{Name: "Мария"})
+
+	agency.Broadcast("Новая новость: Go 2.0 вышел!")
+	// Output:
+	// Пользователь Алексей получил новость: Новая новость: Go 2.0 вышел!
+	// Пользователь Мария получил новость: Новая новость: Go 2.0 вышел!
+}
@@ -0,0 +1,73 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/observer"
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/observer/pubsub"
+)
+
+// noopSubscriber реализует observer.Subscriber и ничего не делает —
+// изолирует стоимость рассылки от стоимости обработки сообщения.
+type noopSubscriber struct{}
+
+func (noopSubscriber) Notify(string) {}
+
+// BenchmarkNewsAgencyBroadcast измеряет классический вариант Observer:
+// список подписчиков и синхронный вызов Notify под мьютексом.
+func BenchmarkNewsAgencyBroadcast(b *testing.B) {
+	for _, n := range []int{1, 10, 1000} {
+		b.Run(subscriberLabel(n), func(b *testing.B) {
+			agency := &observer.NewsAgency{}
+			for i := 0; i < n; i++ {
+				agency.Register(noopSubscriber{})
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				agency.Broadcast("новость")
+			}
+		})
+	}
+}
+
+// BenchmarkPubSubPublish измеряет канальную версию: рассылка раскладывает
+// сообщение по буферизованным каналам подписчиков, не дожидаясь, пока они
+// его прочитают.
+func BenchmarkPubSubPublish(b *testing.B) {
+	for _, n := range []int{1, 10, 1000} {
+		b.Run(subscriberLabel(n), func(b *testing.B) {
+			ps := pubsub.New[string](1, pubsub.DropNew)
+			for i := 0; i < n; i++ {
+				ch, _ := ps.Subscribe("news")
+				// Забираем сообщения в фоне, иначе буфер из одного слота
+				// быстро заполнится и Publish станет отбрасывать сообщения,
+				// а не измерять рассылку.
+				go func(ch <-chan string) {
+					for range ch {
+					}
+				}(ch)
+			}
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				ps.Publish(ctx, "news", "новость")
+			}
+			b.StopTimer()
+			ps.Close()
+		})
+	}
+}
+
+func subscriberLabel(n int) string {
+	switch n {
+	case 1:
+		return "1_subscriber"
+	case 10:
+		return "10_subscribers"
+	default:
+		return "1000_subscribers"
+	}
+}
@@ -0,0 +1,155 @@
+// Package pubsub реализует канальную версию паттерна "Наблюдатель" —
+// брокер публикации-подписки (Pub/Sub) с типизированными топиками.
+//
+// В отличие от observer.NewsAgency (список подписчиков + синхронный вызов
+// Notify под мьютексом), здесь подписчик получает события через собственный
+// канал и сам решает, когда их читать. Это снимает главную проблему
+// NewsAgency — медленный или зависший подписчик не блокирует Broadcast для
+// остальных, потому что у каждого подписчика свой буфер и своя политика
+// поведения при переполнении.
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy определяет поведение Publish, когда буфер канала
+// подписчика заполнен.
+type OverflowPolicy int
+
+const (
+	// DropNew отбрасывает новое сообщение, если буфер подписчика полон.
+	DropNew OverflowPolicy = iota
+	// DropOldest вытесняет самое старое непрочитанное сообщение подписчика,
+	// освобождая место для нового.
+	DropOldest
+	// Block ждёт, пока в буфере подписчика не появится место, либо пока не
+	// истечёт ctx, переданный в Publish.
+	Block
+)
+
+// PubSub — типизированный брокер публикации-подписки для событий типа T.
+type PubSub[T any] struct {
+	bufferSize int
+	policy     OverflowPolicy
+
+	mu     sync.RWMutex
+	topics map[string]map[int64]chan T
+	nextID int64
+	closed bool
+}
+
+// New создаёт брокер с буфером bufferSize на каждого подписчика и заданной
+// политикой поведения при переполнении буфера.
+func New[T any](bufferSize int, policy OverflowPolicy) *PubSub[T] {
+	return &PubSub[T]{
+		bufferSize: bufferSize,
+		policy:     policy,
+		topics:     make(map[string]map[int64]chan T),
+	}
+}
+
+// Subscribe подписывает на топик и возвращает канал событий и функцию
+// отписки. Unsubscribe закрывает канал подписчика — после её вызова из ch
+// больше ничего не придёт, а он будет закрыт.
+func (p *PubSub[T]) Subscribe(topic string) (<-chan T, func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch := make(chan T, p.bufferSize)
+	id := atomic.AddInt64(&p.nextID, 1)
+
+	if p.topics[topic] == nil {
+		p.topics[topic] = make(map[int64]chan T)
+	}
+	p.topics[topic][id] = ch
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			if subs, ok := p.topics[topic]; ok {
+				if c, ok := subs[id]; ok {
+					delete(subs, id)
+					close(c)
+				}
+			}
+		})
+	}
+	return ch, unsubscribe
+}
+
+// Publish рассылает value всем подписчикам топика согласно политике
+// переполнения брокера. Для Block ctx ограничивает, сколько Publish готов
+// ждать свободного места у медленного подписчика; для DropNew и DropOldest
+// ctx не используется.
+func (p *PubSub[T]) Publish(ctx context.Context, topic string, value T) {
+	p.mu.RLock()
+	subs := p.topics[topic]
+	channels := make([]chan T, 0, len(subs))
+	for _, ch := range subs {
+		channels = append(channels, ch)
+	}
+	p.mu.RUnlock()
+
+	for _, ch := range channels {
+		p.send(ctx, ch, value)
+	}
+}
+
+func (p *PubSub[T]) send(ctx context.Context, ch chan T, value T) {
+	// Unsubscribe может закрыть ch между тем, как Publish сняло снимок
+	// подписчиков, и собственно отправкой — это штатная гонка канальной
+	// версии Observer, а не ошибка; подписчик в любом случае не должен
+	// был получить сообщение после отписки.
+	defer func() { recover() }()
+
+	switch p.policy {
+	case DropOldest:
+		select {
+		case ch <- value:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- value:
+			default:
+				// подписчика только что отписали и закрыли канал — пропускаем.
+			}
+		}
+	case Block:
+		select {
+		case ch <- value:
+		case <-ctx.Done():
+		}
+	default: // DropNew
+		select {
+		case ch <- value:
+		default:
+		}
+	}
+}
+
+// Close отписывает и закрывает каналы всех подписчиков всех топиков.
+// После Close публикации ничего не делают (подписчиков больше нет).
+func (p *PubSub[T]) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+	p.closed = true
+	for topic, subs := range p.topics {
+		for id, ch := range subs {
+			close(ch)
+			delete(subs, id)
+		}
+		delete(p.topics, topic)
+	}
+}
@@ -0,0 +1,118 @@
+package pubsub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/observer/pubsub"
+)
+
+func TestSubscribePublish(t *testing.T) {
+	ps := pubsub.New[string](4, pubsub.DropNew)
+	ch, unsubscribe := ps.Subscribe("news")
+	defer unsubscribe()
+
+	ps.Publish(context.Background(), "news", "hello")
+
+	select {
+	case got := <-ch:
+		if got != "hello" {
+			t.Errorf("got %q, want %q", got, "hello")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+}
+
+func TestUnsubscribeClosesChannel(t *testing.T) {
+	ps := pubsub.New[int](1, pubsub.DropNew)
+	ch, unsubscribe := ps.Subscribe("topic")
+	unsubscribe()
+
+	_, ok := <-ch
+	if ok {
+		t.Error("channel not closed after Unsubscribe")
+	}
+}
+
+func TestDropNewDropsWhenFull(t *testing.T) {
+	ps := pubsub.New[int](1, pubsub.DropNew)
+	ch, unsubscribe := ps.Subscribe("topic")
+	defer unsubscribe()
+
+	ps.Publish(context.Background(), "topic", 1)
+	ps.Publish(context.Background(), "topic", 2) // буфер полон — отбрасывается
+
+	if got := <-ch; got != 1 {
+		t.Errorf("got %d, want 1", got)
+	}
+	select {
+	case got := <-ch:
+		t.Errorf("unexpected second message %d, buffer should have dropped it", got)
+	default:
+	}
+}
+
+func TestDropOldestKeepsNewest(t *testing.T) {
+	ps := pubsub.New[int](1, pubsub.DropOldest)
+	ch, unsubscribe := ps.Subscribe("topic")
+	defer unsubscribe()
+
+	ps.Publish(context.Background(), "topic", 1)
+	ps.Publish(context.Background(), "topic", 2) // вытесняет 1
+
+	if got := <-ch; got != 2 {
+		t.Errorf("got %d, want 2 (oldest message should have been dropped)", got)
+	}
+}
+
+func TestBlockRespectsContextTimeout(t *testing.T) {
+	ps := pubsub.New[int](1, pubsub.Block)
+	_, unsubscribe := ps.Subscribe("topic")
+	defer unsubscribe()
+
+	ps.Publish(context.Background(), "topic", 1) // заполняет буфер
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		ps.Publish(ctx, "topic", 2) // должен разблокироваться по таймауту
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Publish with Block policy did not respect context timeout")
+	}
+}
+
+func TestCloseClosesAllSubscribers(t *testing.T) {
+	ps := pubsub.New[int](1, pubsub.DropNew)
+	ch1, _ := ps.Subscribe("a")
+	ch2, _ := ps.Subscribe("b")
+
+	ps.Close()
+
+	if _, ok := <-ch1; ok {
+		t.Error("ch1 not closed after Close")
+	}
+	if _, ok := <-ch2; ok {
+		t.Error("ch2 not closed after Close")
+	}
+}
+
+func ExamplePubSub() {
+	ps := pubsub.New[string](1, pubsub.DropNew)
+	ch, unsubscribe := ps.Subscribe("news")
+	defer unsubscribe()
+
+	ps.Publish(context.Background(), "news", "Go 2.0 вышел!")
+	fmt.Println(<-ch)
+	// Output:
+	// Go 2.0 вышел!
+}
@@ -0,0 +1,55 @@
+// Package state реализует паттерн "Состояние" (State).
+//
+// Проблема: поведение объекта зависит от его текущего состояния, и это
+// превращается в длинную цепочку switch по полю-флагу, разбросанную по
+// всем методам. Решение: вынести поведение каждого состояния в отдельный
+// тип, реализующий общий интерфейс, а сам объект лишь делегирует вызовы
+// текущему состоянию.
+package state
+
+// OrderState — поведение заказа в конкретном состоянии.
+type OrderState interface {
+	Next(o *Order) OrderState
+	Name() string
+}
+
+type newState struct{}
+
+func (newState) Name() string { return "Новый" }
+func (newState) Next(o *Order) OrderState {
+	return paidState{}
+}
+
+type paidState struct{}
+
+func (paidState) Name() string { return "Оплачен" }
+func (paidState) Next(o *Order) OrderState {
+	return shippedState{}
+}
+
+type shippedState struct{}
+
+func (shippedState) Name() string { return "Отправлен" }
+func (shippedState) Next(o *Order) OrderState {
+	return shippedState{} // конечное состояние: дальше переходов нет
+}
+
+// Order — заказ, поведение Advance которого зависит от текущего состояния.
+type Order struct {
+	state OrderState
+}
+
+// NewOrder создаёт заказ в начальном состоянии "Новый".
+func NewOrder() *Order {
+	return &Order{state: newState{}}
+}
+
+// Advance переводит заказ в следующее состояние.
+func (o *Order) Advance() {
+	o.state = o.state.Next(o)
+}
+
+// State возвращает название текущего состояния.
+func (o *Order) State() string {
+	return o.state.Name()
+}
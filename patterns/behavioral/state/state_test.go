@@ -0,0 +1,37 @@
+package state_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/state"
+)
+
+func TestOrderAdvancesThroughStates(t *testing.T) {
+	order := state.NewOrder()
+
+	wantStates := []string{"Новый", "Оплачен", "Отправлен", "Отправлен"}
+	for i, want := range wantStates {
+		if i > 0 {
+			order.Advance()
+		}
+		if got := order.State(); got != want {
+			t.Errorf("step %d: State() = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func ExampleOrder_Advance() {
+	order := state.NewOrder()
+	fmt.Println(order.State())
+
+	order.Advance()
+	fmt.Println(order.State())
+
+	order.Advance()
+	fmt.Println(order.State())
+	// Output:
+	// Новый
+	// Оплачен
+	// Отправлен
+}
@@ -0,0 +1,46 @@
+// Package strategy реализует паттерн "Стратегия" (Strategy).
+//
+// Определяет семейство взаимозаменяемых алгоритмов оплаты, выбираемых во
+// время выполнения без изменения кода ShoppingCart.
+package strategy
+
+import "fmt"
+
+// PaymentStrategy — алгоритм оплаты.
+type PaymentStrategy interface {
+	Pay(amount float64) string
+}
+
+// CashPayment — оплата наличными.
+type CashPayment struct{}
+
+// Pay реализует PaymentStrategy.
+func (c *CashPayment) Pay(amount float64) string {
+	return fmt.Sprintf("Оплата наличными: %v рублей", amount)
+}
+
+// CreditCardPayment — оплата кредитной картой.
+type CreditCardPayment struct{}
+
+// Pay реализует PaymentStrategy.
+func (c *CreditCardPayment) Pay(amount float64) string {
+	return fmt.Sprintf("Оплата кредитной картой: %v рублей", amount)
+}
+
+// ShoppingCart — контекст, использующий выбранную стратегию оплаты.
+type ShoppingCart struct {
+	paymentStrategy PaymentStrategy
+}
+
+// SetPaymentStrategy задаёт стратегию оплаты.
+func (s *ShoppingCart) SetPaymentStrategy(strategy PaymentStrategy) {
+	s.paymentStrategy = strategy
+}
+
+// Checkout оплачивает корзину текущей стратегией.
+func (s *ShoppingCart) Checkout(amount float64) string {
+	if s.paymentStrategy == nil {
+		return "Стратегия оплаты не выбрана"
+	}
+	return s.paymentStrategy.Pay(amount)
+}
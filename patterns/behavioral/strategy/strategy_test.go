@@ -0,0 +1,46 @@
+package strategy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/strategy"
+)
+
+func TestShoppingCartCheckout(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy strategy.PaymentStrategy
+		amount   float64
+		want     string
+	}{
+		{name: "no strategy", strategy: nil, amount: 100, want: "Стратегия оплаты не выбрана"},
+		{name: "cash", strategy: &strategy.CashPayment{}, amount: 100, want: "Оплата наличными: 100 рублей"},
+		{name: "credit card", strategy: &strategy.CreditCardPayment{}, amount: 200, want: "Оплата кредитной картой: 200 рублей"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cart := &strategy.ShoppingCart{}
+			if tt.strategy != nil {
+				cart.SetPaymentStrategy(tt.strategy)
+			}
+			if got := cart.Checkout(tt.amount); got != tt.want {
+				t.Errorf("Checkout(%v) = %q, want %q", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleShoppingCart_Checkout() {
+	cart := &strategy.ShoppingCart{}
+
+	cart.SetPaymentStrategy(&strategy.CashPayment{})
+	fmt.Println(cart.Checkout(100.0))
+
+	cart.SetPaymentStrategy(&strategy.CreditCardPayment{})
+	fmt.Println(cart.Checkout(200.0))
+	// Output:
+	// Оплата наличными: 100 рублей
+	// Оплата кредитной картой: 200 рублей
+}
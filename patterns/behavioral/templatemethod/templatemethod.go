@@ -0,0 +1,47 @@
+// Package templatemethod реализует паттерн "Шаблонный метод"
+// (Template Method).
+//
+// Проблема: несколько алгоритмов повторяют один и тот же скелет шагов,
+// отличаясь только в деталях отдельных шагов. Решение: зафиксировать
+// скелет в одном месте, а различающиеся шаги вынести в интерфейс, который
+// реализует каждый конкретный алгоритм.
+//
+// Go-идиома: вместо наследования с protected-методами (как в классическом
+// ООП) скелет — это свободная функция, принимающая интерфейс с шагами.
+package templatemethod
+
+// Beverage — шаги приготовления напитка, которые отличаются между
+// конкретными напитками.
+type Beverage interface {
+	Brew() string
+	AddCondiments() string
+}
+
+// Prepare — шаблонный метод: фиксированная последовательность шагов
+// приготовления любого напитка.
+func Prepare(b Beverage) []string {
+	return []string{
+		"Кипятим воду",
+		b.Brew(),
+		"Наливаем в чашку",
+		b.AddCondiments(),
+	}
+}
+
+// Tea — чай: заваривается и приправляется лимоном.
+type Tea struct{}
+
+// Brew реализует Beverage.
+func (Tea) Brew() string { return "Завариваем чай" }
+
+// AddCondiments реализует Beverage.
+func (Tea) AddCondiments() string { return "Добавляем лимон" }
+
+// Coffee — кофе: варится и приправляется сахаром с молоком.
+type Coffee struct{}
+
+// Brew реализует Beverage.
+func (Coffee) Brew() string { return "Варим кофе" }
+
+// AddCondiments реализует Beverage.
+func (Coffee) AddCondiments() string { return "Добавляем сахар и молоко" }
@@ -0,0 +1,34 @@
+package templatemethod_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/templatemethod"
+)
+
+func TestPrepareSharesSkeleton(t *testing.T) {
+	tea := templatemethod.Prepare(templatemethod.Tea{})
+	coffee := templatemethod.Prepare(templatemethod.Coffee{})
+
+	wantTea := []string{"Кипятим воду", "Завариваем чай", "Наливаем в чашку", "Добавляем лимон"}
+	if !reflect.DeepEqual(tea, wantTea) {
+		t.Errorf("Prepare(Tea{}) = %v, want %v", tea, wantTea)
+	}
+
+	if tea[0] != coffee[0] || tea[2] != coffee[2] {
+		t.Errorf("Prepare() should share the fixed steps between beverages")
+	}
+}
+
+func ExamplePrepare() {
+	for _, step := range templatemethod.Prepare(templatemethod.Coffee{}) {
+		fmt.Println(step)
+	}
+	// Output:
+	// Кипятим воду
+	// Варим кофе
+	// Наливаем в чашку
+	// Добавляем сахар и молоко
+}
@@ -0,0 +1,52 @@
+// Package visitor реализует паттерн "Посетитель" (Visitor).
+//
+// Проблема: нужно добавлять в иерархию фигур новые операции (площадь,
+// сериализация в JSON, отрисовка), не трогая сами типы фигур каждый раз.
+// Решение: операция выносится в отдельный тип-посетитель с методом на
+// каждый конкретный тип фигуры; сами фигуры лишь принимают посетителя и
+// вызывают нужный его метод (double dispatch).
+package visitor
+
+// Shape — фигура, умеющая принять Visitor.
+type Shape interface {
+	Accept(v Visitor) float64
+}
+
+// Visitor — операция над конкретными фигурами.
+type Visitor interface {
+	VisitCircle(c *Circle) float64
+	VisitSquare(s *Square) float64
+}
+
+// Circle — круг с радиусом.
+type Circle struct {
+	Radius float64
+}
+
+// Accept реализует Shape.
+func (c *Circle) Accept(v Visitor) float64 {
+	return v.VisitCircle(c)
+}
+
+// Square — квадрат со стороной.
+type Square struct {
+	Side float64
+}
+
+// Accept реализует Shape.
+func (s *Square) Accept(v Visitor) float64 {
+	return v.VisitSquare(s)
+}
+
+// AreaVisitor считает площадь фигуры.
+type AreaVisitor struct{}
+
+// VisitCircle реализует Visitor.
+func (AreaVisitor) VisitCircle(c *Circle) float64 {
+	return 3.14159 * c.Radius * c.Radius
+}
+
+// VisitSquare реализует Visitor.
+func (AreaVisitor) VisitSquare(s *Square) float64 {
+	return s.Side * s.Side
+}
@@ -0,0 +1,36 @@
+package visitor_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/behavioral/visitor"
+)
+
+func TestAreaVisitor(t *testing.T) {
+	shapes := []visitor.Shape{
+		&visitor.Circle{Radius: 2},
+		&visitor.Square{Side: 3},
+	}
+	areaVisitor := visitor.AreaVisitor{}
+
+	wantAreas := []float64{12.56636, 9}
+	for i, s := range shapes {
+		got := s.Accept(areaVisitor)
+		if math.Abs(got-wantAreas[i]) > 1e-6 {
+			t.Errorf("shape %d: Accept(AreaVisitor{}) = %v, want %v", i, got, wantAreas[i])
+		}
+	}
+}
+
+func ExampleAreaVisitor() {
+	shapes := []visitor.Shape{
+		&visitor.Square{Side: 3},
+	}
+	for _, s := range shapes {
+		fmt.Println(s.Accept(visitor.AreaVisitor{}))
+	}
+	// Output:
+	// 9
+}
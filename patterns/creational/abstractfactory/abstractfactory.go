@@ -0,0 +1,60 @@
+// Package abstractfactory реализует паттерн "Абстрактная фабрика"
+// (Abstract Factory).
+//
+// Проблема: нужно создавать семейства связанных объектов (кресло + диван
+// одного стиля), не допуская смешивания объектов из разных семейств.
+// Решение: одна фабрика на семейство, реализующая общий интерфейс.
+//
+// Go-идиома: фабрика — это обычный интерфейс с методами-конструкторами;
+// конкретные фабрики — структуры или пустые типы, реализующие интерфейс.
+package abstractfactory
+
+// Chair — кресло.
+type Chair interface {
+	SitOn() string
+}
+
+// Sofa — диван.
+type Sofa interface {
+	LieOn() string
+}
+
+// FurnitureFactory создаёт кресло и диван одного стиля.
+type FurnitureFactory interface {
+	CreateChair() Chair
+	CreateSofa() Sofa
+}
+
+type modernChair struct{}
+
+func (modernChair) SitOn() string { return "Сидим в современном кресле" }
+
+type modernSofa struct{}
+
+func (modernSofa) LieOn() string { return "Лежим на современном диване" }
+
+// ModernFactory — фабрика мебели в современном стиле.
+type ModernFactory struct{}
+
+// CreateChair реализует FurnitureFactory.
+func (ModernFactory) CreateChair() Chair { return modernChair{} }
+
+// CreateSofa реализует FurnitureFactory.
+func (ModernFactory) CreateSofa() Sofa { return modernSofa{} }
+
+type victorianChair struct{}
+
+func (victorianChair) SitOn() string { return "Сидим в викторианском кресле" }
+
+type victorianSofa struct{}
+
+func (victorianSofa) LieOn() string { return "Лежим на викторианском диване" }
+
+// VictorianFactory — фабрика мебели в викторианском стиле.
+type VictorianFactory struct{}
+
+// CreateChair реализует FurnitureFactory.
+func (VictorianFactory) CreateChair() Chair { return victorianChair{} }
+
+// CreateSofa реализует FurnitureFactory.
+func (VictorianFactory) CreateSofa() Sofa { return victorianSofa{} }
@@ -0,0 +1,41 @@
+package abstractfactory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/creational/abstractfactory"
+)
+
+func TestFactoriesProduceMatchingStyle(t *testing.T) {
+	tests := []struct {
+		name      string
+		factory   abstractfactory.FurnitureFactory
+		wantChair string
+		wantSofa  string
+	}{
+		{name: "modern", factory: abstractfactory.ModernFactory{}, wantChair: "Сидим в современном кресле", wantSofa: "Лежим на современном диване"},
+		{name: "victorian", factory: abstractfactory.VictorianFactory{}, wantChair: "Сидим в викторианском кресле", wantSofa: "Лежим на викторианском диване"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.factory.CreateChair().SitOn(); got != tt.wantChair {
+				t.Errorf("CreateChair().SitOn() = %q, want %q", got, tt.wantChair)
+			}
+			if got := tt.factory.CreateSofa().LieOn(); got != tt.wantSofa {
+				t.Errorf("CreateSofa().LieOn() = %q, want %q", got, tt.wantSofa)
+			}
+		})
+	}
+}
+
+func ExampleModernFactory() {
+	var factory abstractfactory.FurnitureFactory = abstractfactory.ModernFactory{}
+
+	fmt.Println(factory.CreateChair().SitOn())
+	fmt.Println(factory.CreateSofa().LieOn())
+	// Output:
+	// Сидим в современном кресле
+	// Лежим на современном диване
+}
@@ -0,0 +1,72 @@
+// Package builder реализует паттерн "Строитель" (Builder).
+//
+// Проблема: конструктор с десятком опциональных полей (или десяток
+// перегруженных конструкторов) плохо читается и легко перепутать порядок
+// аргументов. Решение: вынести сборку объекта в цепочку шагов со своими
+// именами, а Director — зафиксировать типовые последовательности шагов.
+//
+// Go-идиома: то же самое чаще всего решают functional options
+// (New(opts ...Option)), но классический Builder с цепочкой вызовов уместен,
+// когда шаги сборки должны выполняться в строгом порядке и сам процесс
+// сборки — это часть публичного API, а не деталь конструктора.
+package builder
+
+// House — то, что собирает Builder.
+type House struct {
+	Walls     int
+	Windows   int
+	HasGarage bool
+	HasPool   bool
+}
+
+// HouseBuilder собирает House шаг за шагом.
+type HouseBuilder struct {
+	house House
+}
+
+// NewHouseBuilder создаёт пустого строителя.
+func NewHouseBuilder() *HouseBuilder {
+	return &HouseBuilder{}
+}
+
+// SetWalls задаёт число стен.
+func (b *HouseBuilder) SetWalls(n int) *HouseBuilder {
+	b.house.Walls = n
+	return b
+}
+
+// SetWindows задаёт число окон.
+func (b *HouseBuilder) SetWindows(n int) *HouseBuilder {
+	b.house.Windows = n
+	return b
+}
+
+// AddGarage добавляет гараж.
+func (b *HouseBuilder) AddGarage() *HouseBuilder {
+	b.house.HasGarage = true
+	return b
+}
+
+// AddPool добавляет бассейн.
+func (b *HouseBuilder) AddPool() *HouseBuilder {
+	b.house.HasPool = true
+	return b
+}
+
+// Build возвращает собранный House.
+func (b *HouseBuilder) Build() House {
+	return b.house
+}
+
+// Director знает типовые последовательности шагов строителя.
+type Director struct{}
+
+// BuildCottage собирает обычный загородный дом: четыре стены, окна, гараж.
+func (Director) BuildCottage(b *HouseBuilder) House {
+	return b.SetWalls(4).SetWindows(6).AddGarage().Build()
+}
+
+// BuildVilla собирает виллу: больше стен, окон, гараж и бассейн.
+func (Director) BuildVilla(b *HouseBuilder) House {
+	return b.SetWalls(8).SetWindows(12).AddGarage().AddPool().Build()
+}
@@ -0,0 +1,42 @@
+package builder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/creational/builder"
+)
+
+func TestHouseBuilderChaining(t *testing.T) {
+	house := builder.NewHouseBuilder().
+		SetWalls(4).
+		SetWindows(8).
+		AddGarage().
+		Build()
+
+	want := builder.House{Walls: 4, Windows: 8, HasGarage: true}
+	if house != want {
+		t.Errorf("Build() = %+v, want %+v", house, want)
+	}
+}
+
+func TestDirector(t *testing.T) {
+	d := builder.Director{}
+
+	cottage := d.BuildCottage(builder.NewHouseBuilder())
+	if !cottage.HasGarage || cottage.HasPool {
+		t.Errorf("BuildCottage() = %+v, want garage without pool", cottage)
+	}
+
+	villa := d.BuildVilla(builder.NewHouseBuilder())
+	if !villa.HasGarage || !villa.HasPool {
+		t.Errorf("BuildVilla() = %+v, want garage and pool", villa)
+	}
+}
+
+func ExampleHouseBuilder() {
+	house := builder.NewHouseBuilder().SetWalls(4).SetWindows(6).AddGarage().Build()
+	fmt.Printf("%+v\n", house)
+	// Output:
+	// {Walls:4 Windows:6 HasGarage:true HasPool:false}
+}
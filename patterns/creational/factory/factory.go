@@ -0,0 +1,50 @@
+// Package factory реализует паттерн "Фабрика" (Factory).
+//
+// Создаёт объекты, не раскрывая клиенту их конкретный тип — клиент работает
+// только с интерфейсом Vehicle.
+package factory
+
+import "fmt"
+
+// Vehicle — общий интерфейс для всех транспортных средств, которые умеет
+// создавать фабрика.
+type Vehicle interface {
+	Drive() string
+}
+
+// Car — автомобиль.
+type Car struct{}
+
+// Drive возвращает описание поездки на машине.
+func (c *Car) Drive() string {
+	return "Еду на машине!"
+}
+
+// Airplane — самолёт.
+type Airplane struct{}
+
+// Drive возвращает описание полёта на самолёте.
+func (a *Airplane) Drive() string {
+	return "Лечу на самолёте!"
+}
+
+// ErrUnknownVehicle возвращается, если CreateVehicle не знает такой тип.
+type ErrUnknownVehicle struct {
+	VehicleType string
+}
+
+func (e *ErrUnknownVehicle) Error() string {
+	return fmt.Sprintf("factory: неизвестный тип транспорта %q", e.VehicleType)
+}
+
+// CreateVehicle создаёт транспортное средство по его строковому имени.
+func CreateVehicle(vehicleType string) (Vehicle, error) {
+	switch vehicleType {
+	case "car":
+		return &Car{}, nil
+	case "airplane":
+		return &Airplane{}, nil
+	default:
+		return nil, &ErrUnknownVehicle{VehicleType: vehicleType}
+	}
+}
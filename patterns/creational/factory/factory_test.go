@@ -0,0 +1,50 @@
+package factory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/creational/factory"
+)
+
+func TestCreateVehicle(t *testing.T) {
+	tests := []struct {
+		name        string
+		vehicleType string
+		want        string
+		wantErr     bool
+	}{
+		{name: "car", vehicleType: "car", want: "Еду на машине!"},
+		{name: "airplane", vehicleType: "airplane", want: "Лечу на самолёте!"},
+		{name: "unknown", vehicleType: "submarine", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := factory.CreateVehicle(tt.vehicleType)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("CreateVehicle(%q) = %v, want error", tt.vehicleType, v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateVehicle(%q) unexpected error: %v", tt.vehicleType, err)
+			}
+			if got := v.Drive(); got != tt.want {
+				t.Errorf("Drive() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleCreateVehicle() {
+	car, _ := factory.CreateVehicle("car")
+	airplane, _ := factory.CreateVehicle("airplane")
+
+	fmt.Println(car.Drive())
+	fmt.Println(airplane.Drive())
+	// Output:
+	// Еду на машине!
+	// Лечу на самолёте!
+}
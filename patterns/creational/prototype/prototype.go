@@ -0,0 +1,25 @@
+// Package prototype реализует паттерн "Прототип" (Prototype).
+//
+// Проблема: создание нового объекта иногда дороже (или сложнее), чем
+// копирование уже готового и похожего экземпляра. Решение: объект умеет
+// клонировать сам себя, не раскрывая вызывающему коду свою внутреннюю
+// структуру.
+//
+// Go-идиома: метод Clone() с явным глубоким копированием вложенных
+// ссылочных полей (слайсы, мапы, указатели) — Go не копирует их
+// автоматически при присваивании структуры.
+package prototype
+
+// Document — документ со списком тегов, которые можно клонировать.
+type Document struct {
+	Title string
+	Tags  []string
+}
+
+// Clone возвращает независимую глубокую копию документа: изменение тегов
+// клона не влияет на оригинал.
+func (d *Document) Clone() *Document {
+	tags := make([]string, len(d.Tags))
+	copy(tags, d.Tags)
+	return &Document{Title: d.Title, Tags: tags}
+}
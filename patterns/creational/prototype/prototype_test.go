@@ -0,0 +1,45 @@
+package prototype_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/creational/prototype"
+)
+
+func TestCloneIsIndependent(t *testing.T) {
+	original := &prototype.Document{Title: "Черновик", Tags: []string{"go"}}
+	clone := original.Clone()
+
+	clone.Title = "Копия"
+	clone.Tags[0] = "изменено"
+
+	if original.Title != "Черновик" {
+		t.Errorf("original.Title = %q, want unchanged", original.Title)
+	}
+	if original.Tags[0] != "go" {
+		t.Errorf("original.Tags[0] = %q, want unchanged", original.Tags[0])
+	}
+}
+
+func TestCloneEqualByValue(t *testing.T) {
+	original := &prototype.Document{Title: "Черновик", Tags: []string{"go", "patterns"}}
+	clone := original.Clone()
+
+	if !reflect.DeepEqual(original, clone) {
+		t.Errorf("Clone() = %+v, want deep-equal to %+v", clone, original)
+	}
+}
+
+func ExampleDocument_Clone() {
+	original := &prototype.Document{Title: "Черновик", Tags: []string{"go"}}
+	clone := original.Clone()
+	clone.Title = "Копия"
+
+	fmt.Println(original.Title)
+	fmt.Println(clone.Title)
+	// Output:
+	// Черновик
+	// Копия
+}
@@ -0,0 +1,45 @@
+// Package singleton реализует паттерн "Одиночка" (Singleton).
+//
+// Гарантирует, что у логгера есть только один экземпляр и предоставляет
+// глобальную точку доступа к нему. В Go для этого не нужен приватный
+// конструктор — достаточно sync.Once и пакетной области видимости.
+package singleton
+
+import "sync"
+
+// Logger копит сообщения в памяти. Единственный экземпляр создаётся
+// через GetInstance.
+type Logger struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+var (
+	instance *Logger
+	once     sync.Once
+)
+
+// GetInstance возвращает единственный экземпляр Logger, создавая его
+// при первом вызове.
+func GetInstance() *Logger {
+	once.Do(func() {
+		instance = &Logger{}
+	})
+	return instance
+}
+
+// Log добавляет сообщение в лог.
+func (l *Logger) Log(message string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, message)
+}
+
+// Messages возвращает копию накопленных сообщений.
+func (l *Logger) Messages() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	out := make([]string, len(l.messages))
+	copy(out, l.messages)
+	return out
+}
@@ -0,0 +1,52 @@
+package singleton_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/creational/singleton"
+)
+
+func TestGetInstanceReturnsSameValue(t *testing.T) {
+	a := singleton.GetInstance()
+	b := singleton.GetInstance()
+
+	if a != b {
+		t.Fatalf("GetInstance() returned different instances: %p != %p", a, b)
+	}
+}
+
+func TestGetInstanceIsConcurrencySafe(t *testing.T) {
+	var wg sync.WaitGroup
+	instances := make([]*singleton.Logger, 50)
+
+	for i := range instances {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			instances[i] = singleton.GetInstance()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, got := range instances {
+		if got != instances[0] {
+			t.Fatalf("instance %d differs from instance 0", i)
+		}
+	}
+}
+
+func ExampleGetInstance() {
+	logger1 := singleton.GetInstance()
+	logger1.Log("первый лог")
+
+	logger2 := singleton.GetInstance()
+	logger2.Log("второй лог")
+
+	fmt.Println(logger1 == logger2)
+	fmt.Println(logger2.Messages())
+	// Output:
+	// true
+	// [первый лог второй лог]
+}
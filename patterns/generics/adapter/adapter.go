@@ -0,0 +1,29 @@
+// Package adapter — дженерик-версия паттерна "Адаптер".
+//
+// patterns/structural/adapter пишет отдельную структуру-обёртку
+// (NotificationAdapter) на каждую пару "старый сервис -> новый интерфейс".
+// Func[Old, Target] обобщает саму обёртку, оставляя специфичной только
+// функцию конвертации.
+//
+// Плюсы: не нужен новый именованный тип на каждую адаптацию — достаточно
+// func(Old) Target.
+// Минусы: теряется место для собственного состояния адаптера (например,
+// кэша последнего результата) — тип Func[Old, Target] хранит только саму
+// функцию, без полей.
+package adapter
+
+// Func адаптирует значение типа Old к типу Target через convert.
+type Func[Old, Target any] struct {
+	value   Old
+	convert func(Old) Target
+}
+
+// NewFunc создаёт адаптер над value с функцией конвертации convert.
+func NewFunc[Old, Target any](value Old, convert func(Old) Target) *Func[Old, Target] {
+	return &Func[Old, Target]{value: value, convert: convert}
+}
+
+// Adapt возвращает value, преобразованное к Target.
+func (a *Func[Old, Target]) Adapt() Target {
+	return a.convert(a.value)
+}
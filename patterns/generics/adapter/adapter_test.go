@@ -0,0 +1,34 @@
+package adapter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/generics/adapter"
+)
+
+type oldNotificationService struct{}
+
+func (oldNotificationService) Notify(message string) string {
+	return fmt.Sprintf("Старый сервис: %s", message)
+}
+
+type notification interface {
+	Send(message string) string
+}
+
+type sendFunc func(message string) string
+
+func (f sendFunc) Send(message string) string { return f(message) }
+
+func TestFuncAdapt(t *testing.T) {
+	old := oldNotificationService{}
+	a := adapter.NewFunc[oldNotificationService, notification](old, func(o oldNotificationService) notification {
+		return sendFunc(o.Notify)
+	})
+
+	want := "Старый сервис: Привет, мир!"
+	if got := a.Adapt().Send("Привет, мир!"); got != want {
+		t.Errorf("Send() = %q, want %q", got, want)
+	}
+}
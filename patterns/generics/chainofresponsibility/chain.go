@@ -0,0 +1,37 @@
+// Package chainofresponsibility — дженерик-версия паттерна "Цепочка
+// обязанностей".
+//
+// patterns/behavioral/chainofresponsibility жёстко завязан на Kid и строку
+// с именем. Handler[Req, Res] обобщает звено цепочки на любые Req/Res:
+// каждый обработчик получает запрос и следующий обработчик как значение
+// (ленивая передача), а не как заранее построенный связный список.
+//
+// Плюсы: типобезопасный запрос и ответ любой формы, не только "спросить
+// ребёнка".
+// Минусы: ленивая передача next означает, что порядок вызовов следующего
+// звена — забота каждого обработчика; забытый вызов next() молча обрывает
+// цепочку, и компилятор этого не заметит.
+package chainofresponsibility
+
+// Next — продолжение цепочки: следующий обработчик, вызываемый лениво.
+type Next[Req, Res any] func(Req) Res
+
+// Handler обрабатывает запрос, при необходимости передавая его по цепочке
+// дальше через next.
+type Handler[Req, Res any] func(req Req, next Next[Req, Res]) Res
+
+// Chain строгует список обработчиков в один Next, вызываемый по порядку:
+// первый обработчик решает сам, вызывать ли следующий.
+func Chain[Req, Res any](handlers ...Handler[Req, Res]) Next[Req, Res] {
+	var zero Res
+	var build func(i int) Next[Req, Res]
+	build = func(i int) Next[Req, Res] {
+		if i >= len(handlers) {
+			return func(Req) Res { return zero }
+		}
+		return func(req Req) Res {
+			return handlers[i](req, build(i+1))
+		}
+	}
+	return build(0)
+}
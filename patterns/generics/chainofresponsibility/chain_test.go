@@ -0,0 +1,39 @@
+package chainofresponsibility_test
+
+import (
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/generics/chainofresponsibility"
+)
+
+func askingHandler(name string, wantsIt bool) chainofresponsibility.Handler[string, string] {
+	return func(req string, next chainofresponsibility.Next[string, string]) string {
+		if wantsIt {
+			return name
+		}
+		return next(req)
+	}
+}
+
+func TestChainStopsAtFirstWillingHandler(t *testing.T) {
+	chain := chainofresponsibility.Chain(
+		askingHandler("Вася", false),
+		askingHandler("Петя", false),
+		askingHandler("Маша", true),
+	)
+
+	if got, want := chain("яблоко"), "Маша"; got != want {
+		t.Errorf("chain(\"яблоко\") = %q, want %q", got, want)
+	}
+}
+
+func TestChainReturnsZeroValueWhenNobodyHandles(t *testing.T) {
+	chain := chainofresponsibility.Chain(
+		askingHandler("Вася", false),
+		askingHandler("Петя", false),
+	)
+
+	if got := chain("яблоко"); got != "" {
+		t.Errorf("chain(\"яблоко\") = %q, want \"\"", got)
+	}
+}
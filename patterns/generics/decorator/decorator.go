@@ -0,0 +1,21 @@
+// Package decorator — дженерик-версия паттерна "Декоратор".
+//
+// patterns/structural/decorator оборачивает Beverage в Beverage через
+// встраивание интерфейса в структуру. Decorate делает то же самое одной
+// функцией для любого T: каждая func(T) T — это один слой обёртки.
+//
+// Плюсы: не нужен отдельный тип-обёртка на каждый слой (MilkDecorator,
+// SugarDecorator) — слой это просто функция.
+// Минусы: подходит только пока декораторы не добавляют собственное
+// состояние или собственные методы сверх T — как только декоратору нужно
+// что-то своё (например, кэш в прокси-декораторе), снова нужна структура.
+package decorator
+
+// Decorate последовательно применяет слои к value — каждый following
+// оборачивает результат предыдущего.
+func Decorate[T any](value T, layers ...func(T) T) T {
+	for _, layer := range layers {
+		value = layer(value)
+	}
+	return value
+}
@@ -0,0 +1,24 @@
+package decorator_test
+
+import (
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/generics/decorator"
+)
+
+func TestDecorateAppliesLayersInOrder(t *testing.T) {
+	withMilk := func(s string) string { return s + ", с молоком" }
+	withSugar := func(s string) string { return s + ", с сахаром" }
+
+	got := decorator.Decorate("Простой кофе", withMilk, withSugar)
+
+	if want := "Простой кофе, с молоком, с сахаром"; got != want {
+		t.Errorf("Decorate() = %q, want %q", got, want)
+	}
+}
+
+func TestDecorateWithNoLayers(t *testing.T) {
+	if got := decorator.Decorate(42); got != 42 {
+		t.Errorf("Decorate(42) = %d, want 42", got)
+	}
+}
@@ -0,0 +1,50 @@
+// Package factory — дженерик-версия паттерна "Фабрика".
+//
+// patterns/creational/factory захардкожен под интерфейс Vehicle и строковые
+// ключи "car"/"airplane". Registry[K, T] обобщает это на любой
+// comparable-ключ K и любой T, которым можно торговать через конструктор.
+//
+// Плюсы: один тип фабрики на все случаи жизни вместо пакета на каждое
+// семейство продуктов; ошибка в ключе (K) ловится компилятором, если K —
+// собственный enum-тип, а не голая строка.
+// Минусы: теряется читаемость сигнатуры — "Registry[string, Vehicle]" не
+// так самоописываем, как отдельный тип VehicleFactory с методом
+// CreateVehicle; конкретных шапок ошибок (как ErrUnknownVehicle) тоже
+// приходится делать дженерик-совместимыми.
+package factory
+
+import "fmt"
+
+// ErrUnknownKey возвращается Registry.Create для незарегистрированного ключа.
+type ErrUnknownKey[K any] struct {
+	Key K
+}
+
+func (e *ErrUnknownKey[K]) Error() string {
+	return fmt.Sprintf("factory: неизвестный ключ %v", e.Key)
+}
+
+// Registry сопоставляет ключи K конструкторам значений T.
+type Registry[K comparable, T any] struct {
+	ctors map[K]func() T
+}
+
+// NewRegistry создаёт пустой реестр конструкторов.
+func NewRegistry[K comparable, T any]() *Registry[K, T] {
+	return &Registry[K, T]{ctors: make(map[K]func() T)}
+}
+
+// Register регистрирует конструктор для ключа key.
+func (r *Registry[K, T]) Register(key K, ctor func() T) {
+	r.ctors[key] = ctor
+}
+
+// Create создаёт значение по ключу, вызывая зарегистрированный конструктор.
+func (r *Registry[K, T]) Create(key K) (T, error) {
+	ctor, ok := r.ctors[key]
+	if !ok {
+		var zero T
+		return zero, &ErrUnknownKey[K]{Key: key}
+	}
+	return ctor(), nil
+}
@@ -0,0 +1,47 @@
+package factory_test
+
+import (
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/generics/factory"
+)
+
+type vehicle interface {
+	Drive() string
+}
+
+type car struct{}
+
+func (car) Drive() string { return "Еду на машине!" }
+
+type airplane struct{}
+
+func (airplane) Drive() string { return "Лечу на самолёте!" }
+
+func TestRegistryCreate(t *testing.T) {
+	r := factory.NewRegistry[string, vehicle]()
+	r.Register("car", func() vehicle { return car{} })
+	r.Register("airplane", func() vehicle { return airplane{} })
+
+	got, err := r.Create("car")
+	if err != nil {
+		t.Fatalf("Create(car) unexpected error: %v", err)
+	}
+	if want := "Еду на машине!"; got.Drive() != want {
+		t.Errorf("Drive() = %q, want %q", got.Drive(), want)
+	}
+
+	if _, err := r.Create("submarine"); err == nil {
+		t.Error("Create(submarine) = nil error, want ErrUnknownKey")
+	}
+}
+
+func TestRegistryWorksWithIntKeys(t *testing.T) {
+	r := factory.NewRegistry[int, string]()
+	r.Register(1, func() string { return "one" })
+
+	got, err := r.Create(1)
+	if err != nil || got != "one" {
+		t.Errorf("Create(1) = (%q, %v), want (\"one\", nil)", got, err)
+	}
+}
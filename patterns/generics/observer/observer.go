@@ -0,0 +1,38 @@
+// Package observer — дженерик-версия паттерна "Наблюдатель".
+//
+// patterns/behavioral/observer захардкожен под Subscriber с методом
+// Notify(string). Subject[E] обобщает событие на любой тип E — строка,
+// структура, что угодно — и подписчик это просто func(E), без интерфейса
+// с одним методом.
+//
+// Плюсы: типобезопасность — Subject[OrderEvent] не спутать с
+// Subject[string], компилятор не даст подписать не тот обработчик.
+// Минусы: один Subject обслуживает события ровно одного типа E; если нужно
+// рассылать разнородные события, придётся либо заводить Subject на каждый
+// тип, либо вернуться к interface{}-версии с приведением типа на стороне
+// подписчика.
+package observer
+
+import "sync"
+
+// Subject уведомляет подписчиков о событиях типа E.
+type Subject[E any] struct {
+	mu          sync.RWMutex
+	subscribers []func(E)
+}
+
+// Subscribe добавляет обработчик события.
+func (s *Subject[E]) Subscribe(handler func(E)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = append(s.subscribers, handler)
+}
+
+// Publish уведомляет всех подписчиков о событии event.
+func (s *Subject[E]) Publish(event E) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, handler := range s.subscribers {
+		handler(event)
+	}
+}
@@ -0,0 +1,58 @@
+package observer_test
+
+import (
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/generics/observer"
+)
+
+func TestSubjectWorksForStringEvents(t *testing.T) {
+	subject := &observer.Subject[string]{}
+
+	var got []string
+	subject.Subscribe(func(event string) {
+		got = append(got, event)
+	})
+
+	subject.Publish("Go 2.0 вышел!")
+
+	want := []string{"Go 2.0 вышел!"}
+	if len(got) != 1 || got[0] != want[0] {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+type orderEvent struct {
+	OrderID int
+	Status  string
+}
+
+func TestSubjectWorksForStructEvents(t *testing.T) {
+	subject := &observer.Subject[orderEvent]{}
+
+	var got []orderEvent
+	subject.Subscribe(func(event orderEvent) {
+		got = append(got, event)
+	})
+
+	subject.Publish(orderEvent{OrderID: 1, Status: "shipped"})
+
+	want := orderEvent{OrderID: 1, Status: "shipped"}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("got %v, want [%v]", got, want)
+	}
+}
+
+func TestSubjectNotifiesMultipleSubscribers(t *testing.T) {
+	subject := &observer.Subject[int]{}
+
+	sum := 0
+	subject.Subscribe(func(n int) { sum += n })
+	subject.Subscribe(func(n int) { sum += n * 10 })
+
+	subject.Publish(2)
+
+	if sum != 22 {
+		t.Errorf("sum = %d, want 22", sum)
+	}
+}
@@ -0,0 +1,37 @@
+// Package proxy — дженерик-версия паттерна "Прокси".
+//
+// patterns/structural/proxy пишет отдельный ImageProxy под конкретный
+// Image. Lazy[T] откладывает создание любого T до первого Get — тот же
+// приём ленивой загрузки, но без типа-обёртки на каждый "тяжёлый" объект.
+//
+// Плюсы: одна реализация ленивой загрузки на все типы.
+// Минусы: настоящий Proxy часто добавляет контроль доступа или
+// кэширование результатов вызовов (а не только самого создания) — для
+// этого Lazy[T] недостаточно, нужен прокси, знающий про методы T.
+package proxy
+
+// Lazy откладывает создание T до первого Get.
+type Lazy[T any] struct {
+	loaded bool
+	value  T
+	init   func() T
+}
+
+// NewLazy создаёт прокси, создающий T функцией init при первом Get.
+func NewLazy[T any](init func() T) *Lazy[T] {
+	return &Lazy[T]{init: init}
+}
+
+// Get возвращает T, создавая его при первом вызове.
+func (p *Lazy[T]) Get() T {
+	if !p.loaded {
+		p.value = p.init()
+		p.loaded = true
+	}
+	return p.value
+}
+
+// Loaded сообщает, был ли T уже создан.
+func (p *Lazy[T]) Loaded() bool {
+	return p.loaded
+}
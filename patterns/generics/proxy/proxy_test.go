@@ -0,0 +1,31 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/generics/proxy"
+)
+
+func TestLazyLoadsOnce(t *testing.T) {
+	calls := 0
+	p := proxy.NewLazy(func() string {
+		calls++
+		return "photo.jpg"
+	})
+
+	if p.Loaded() {
+		t.Fatal("Loaded() = true before first Get()")
+	}
+
+	if got := p.Get(); got != "photo.jpg" {
+		t.Errorf("Get() = %q, want %q", got, "photo.jpg")
+	}
+	p.Get()
+
+	if calls != 1 {
+		t.Errorf("init called %d times, want 1", calls)
+	}
+	if !p.Loaded() {
+		t.Error("Loaded() = false after Get()")
+	}
+}
@@ -0,0 +1,36 @@
+// Package singleton — дженерик-версия паттерна "Одиночка".
+//
+// patterns/creational/singleton захардкожен под *Logger. Lazy[T] делает то
+// же самое для любого T: один экземпляр, один sync.Once, без копипасты
+// пакета на каждый тип.
+//
+// Плюсы: типобезопасность — Lazy[Logger] и Lazy[Config] не перепутать
+// местами, в отличие от interface{}-версии, где ошибка всплывёт только в
+// рантайме на приведении типа.
+// Минусы: Go не позволяет завести generic-глобальную переменную
+// (var instance[T] T не компилируется), поэтому каждому типу нужен свой
+// *Lazy[T] — обычно созданный один раз в пакете верхнего уровня.
+package singleton
+
+import "sync"
+
+// Lazy лениво создаёт и кэширует ровно один экземпляр T.
+type Lazy[T any] struct {
+	once sync.Once
+	val  T
+	init func() T
+}
+
+// NewLazy создаёт Lazy с заданной функцией инициализации. init вызывается
+// не здесь, а при первом Get.
+func NewLazy[T any](init func() T) *Lazy[T] {
+	return &Lazy[T]{init: init}
+}
+
+// Get возвращает единственный экземпляр, создавая его при первом вызове.
+func (l *Lazy[T]) Get() T {
+	l.once.Do(func() {
+		l.val = l.init()
+	})
+	return l.val
+}
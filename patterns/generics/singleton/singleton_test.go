@@ -0,0 +1,37 @@
+package singleton_test
+
+import (
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/generics/singleton"
+)
+
+type config struct {
+	Name string
+}
+
+func TestLazyGetReturnsSameValue(t *testing.T) {
+	calls := 0
+	lazy := singleton.NewLazy(func() *config {
+		calls++
+		return &config{Name: "prod"}
+	})
+
+	a := lazy.Get()
+	b := lazy.Get()
+
+	if a != b {
+		t.Fatalf("Get() returned different instances: %p != %p", a, b)
+	}
+	if calls != 1 {
+		t.Errorf("init called %d times, want 1", calls)
+	}
+}
+
+func TestLazyWorksForValueTypes(t *testing.T) {
+	lazy := singleton.NewLazy(func() int { return 42 })
+
+	if got := lazy.Get(); got != 42 {
+		t.Errorf("Get() = %d, want 42", got)
+	}
+}
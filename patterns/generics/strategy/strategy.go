@@ -0,0 +1,21 @@
+// Package strategy — дженерик-версия паттерна "Стратегия".
+//
+// patterns/behavioral/strategy захардкожен под PaymentStrategy
+// (float64 -> string). Strategy[In, Out] — это тот же контракт "функция от
+// In к Out", параметризованный по входу и выходу, поэтому один и тот же тип
+// годится для оплаты, для сортировки, да для чего угодно с такой формой.
+//
+// Плюсы: стратегию можно передать как обычную функцию или замыкание, без
+// отдельного типа-обёртки с одним методом — меньше церемоний.
+// Минусы: теряется место для нескольких методов на стратегию (если
+// понадобится не только Pay, но и Refund, одной функцией уже не обойтись —
+// придётся возвращаться к интерфейсу).
+package strategy
+
+// Strategy — алгоритм, преобразующий In в Out.
+type Strategy[In, Out any] func(In) Out
+
+// Execute применяет стратегию s к значению in.
+func Execute[In, Out any](s Strategy[In, Out], in In) Out {
+	return s(in)
+}
@@ -0,0 +1,26 @@
+package strategy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/generics/strategy"
+)
+
+func TestExecutePaymentStrategy(t *testing.T) {
+	cash := strategy.Strategy[float64, string](func(amount float64) string {
+		return fmt.Sprintf("Оплата наличными: %v рублей", amount)
+	})
+
+	if got, want := strategy.Execute(cash, 100.0), "Оплата наличными: 100 рублей"; got != want {
+		t.Errorf("Execute(cash, 100) = %q, want %q", got, want)
+	}
+}
+
+func TestExecuteWorksForDifferentShapes(t *testing.T) {
+	double := strategy.Strategy[int, int](func(n int) int { return n * 2 })
+
+	if got := strategy.Execute(double, 21); got != 42 {
+		t.Errorf("Execute(double, 21) = %d, want 42", got)
+	}
+}
@@ -0,0 +1,30 @@
+// Package adapter реализует паттерн "Адаптер" (Adapter).
+//
+// Позволяет старому сервису уведомлений работать там, где ожидается новый
+// интерфейс Notification, без изменения кода старого сервиса.
+package adapter
+
+import "fmt"
+
+// Notification — целевой интерфейс, которого ожидает клиентский код.
+type Notification interface {
+	Send(message string) string
+}
+
+// OldNotificationService — старый сервис с несовместимым интерфейсом.
+type OldNotificationService struct{}
+
+// Notify — метод старого сервиса, не соответствующий Notification.
+func (o *OldNotificationService) Notify(message string) string {
+	return fmt.Sprintf("Старый сервис: %s", message)
+}
+
+// NotificationAdapter адаптирует OldNotificationService под Notification.
+type NotificationAdapter struct {
+	OldService *OldNotificationService
+}
+
+// Send реализует Notification, делегируя вызов старому сервису.
+func (a *NotificationAdapter) Send(message string) string {
+	return a.OldService.Notify(message)
+}
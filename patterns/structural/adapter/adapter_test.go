@@ -0,0 +1,29 @@
+package adapter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/structural/adapter"
+)
+
+func TestNotificationAdapterSend(t *testing.T) {
+	oldService := &adapter.OldNotificationService{}
+	var notifier adapter.Notification = &adapter.NotificationAdapter{OldService: oldService}
+
+	want := "Старый сервис: Привет, мир!"
+	if got := notifier.Send("Привет, мир!"); got != want {
+		t.Errorf("Send() = %q, want %q", got, want)
+	}
+}
+
+func ExampleNotificationAdapter() {
+	oldService := &adapter.OldNotificationService{}
+
+	// NotificationAdapter уже реализует Notification — достаточно
+	// присвоить его переменной интерфейсного типа, без приведения типа.
+	var notifier adapter.Notification = &adapter.NotificationAdapter{OldService: oldService}
+	fmt.Println(notifier.Send("Привет, мир!"))
+	// Output:
+	// Старый сервис: Привет, мир!
+}
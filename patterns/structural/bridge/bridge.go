@@ -0,0 +1,42 @@
+// Package bridge реализует паттерн "Мост" (Bridge).
+//
+// Проблема: абстракция (форма) и её реализация (способ отрисовки) растут в
+// двух независимых направлениях, и наследование по обеим осям сразу даёт
+// комбинаторный взрыв подклассов. Решение: разделить их на два независимых
+// интерфейса и связать композицией — абстракция хранит ссылку на
+// реализацию.
+package bridge
+
+import "fmt"
+
+// Renderer — реализация отрисовки, независимая от конкретной фигуры.
+type Renderer interface {
+	RenderCircle(radius float64) string
+}
+
+// VectorRenderer рисует фигуры как векторную графику.
+type VectorRenderer struct{}
+
+// RenderCircle реализует Renderer.
+func (VectorRenderer) RenderCircle(radius float64) string {
+	return fmt.Sprintf("Рисуем векторный круг радиусом %v", radius)
+}
+
+// RasterRenderer рисует фигуры как растровую графику.
+type RasterRenderer struct{}
+
+// RenderCircle реализует Renderer.
+func (RasterRenderer) RenderCircle(radius float64) string {
+	return fmt.Sprintf("Рисуем растровый круг радиусом %v", radius)
+}
+
+// Circle — абстракция фигуры, делегирующая отрисовку мосту Renderer.
+type Circle struct {
+	Renderer Renderer
+	Radius   float64
+}
+
+// Draw рисует круг выбранным способом отрисовки.
+func (c *Circle) Draw() string {
+	return c.Renderer.RenderCircle(c.Radius)
+}
@@ -0,0 +1,35 @@
+package bridge_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/structural/bridge"
+)
+
+func TestCircleDrawUsesChosenRenderer(t *testing.T) {
+	tests := []struct {
+		name     string
+		renderer bridge.Renderer
+		want     string
+	}{
+		{name: "vector", renderer: bridge.VectorRenderer{}, want: "Рисуем векторный круг радиусом 5"},
+		{name: "raster", renderer: bridge.RasterRenderer{}, want: "Рисуем растровый круг радиусом 5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &bridge.Circle{Renderer: tt.renderer, Radius: 5}
+			if got := c.Draw(); got != tt.want {
+				t.Errorf("Draw() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleCircle_Draw() {
+	circle := &bridge.Circle{Renderer: bridge.VectorRenderer{}, Radius: 5}
+	fmt.Println(circle.Draw())
+	// Output:
+	// Рисуем векторный круг радиусом 5
+}
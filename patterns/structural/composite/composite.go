@@ -0,0 +1,58 @@
+// Package composite реализует паттерн "Компоновщик" (Composite).
+//
+// Проблема: нужно единообразно работать и с отдельным объектом, и с группой
+// таких объектов (файл и папка, сотрудник и отдел). Решение: и лист, и узел
+// дерева реализуют один интерфейс.
+package composite
+
+// Employee — узел дерева: либо рядовой сотрудник (лист), либо руководитель
+// с подчинёнными (ветка).
+type Employee interface {
+	Name() string
+	Salary() int
+}
+
+// IndividualEmployee — лист дерева: сотрудник без подчинённых.
+type IndividualEmployee struct {
+	name   string
+	salary int
+}
+
+// NewIndividualEmployee создаёт рядового сотрудника.
+func NewIndividualEmployee(name string, salary int) *IndividualEmployee {
+	return &IndividualEmployee{name: name, salary: salary}
+}
+
+// Name реализует Employee.
+func (e *IndividualEmployee) Name() string { return e.name }
+
+// Salary реализует Employee.
+func (e *IndividualEmployee) Salary() int { return e.salary }
+
+// Department — ветка дерева: группа сотрудников и вложенных отделов.
+type Department struct {
+	name    string
+	members []Employee
+}
+
+// NewDepartment создаёт отдел с заданным именем.
+func NewDepartment(name string) *Department {
+	return &Department{name: name}
+}
+
+// Add добавляет сотрудника или вложенный отдел в состав.
+func (d *Department) Add(e Employee) {
+	d.members = append(d.members, e)
+}
+
+// Name реализует Employee.
+func (d *Department) Name() string { return d.name }
+
+// Salary реализует Employee, суммируя зарплаты всех членов отдела рекурсивно.
+func (d *Department) Salary() int {
+	total := 0
+	for _, m := range d.members {
+		total += m.Salary()
+	}
+	return total
+}
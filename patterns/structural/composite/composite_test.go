@@ -0,0 +1,32 @@
+package composite_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/structural/composite"
+)
+
+func TestDepartmentSalaryIsRecursive(t *testing.T) {
+	backend := composite.NewDepartment("Backend")
+	backend.Add(composite.NewIndividualEmployee("Аня", 100))
+	backend.Add(composite.NewIndividualEmployee("Боря", 150))
+
+	engineering := composite.NewDepartment("Engineering")
+	engineering.Add(backend)
+	engineering.Add(composite.NewIndividualEmployee("Вера", 200))
+
+	if got, want := engineering.Salary(), 450; got != want {
+		t.Errorf("Salary() = %d, want %d", got, want)
+	}
+}
+
+func ExampleDepartment_Salary() {
+	backend := composite.NewDepartment("Backend")
+	backend.Add(composite.NewIndividualEmployee("Аня", 100))
+	backend.Add(composite.NewIndividualEmployee("Боря", 150))
+
+	fmt.Println(backend.Salary())
+	// Output:
+	// 250
+}
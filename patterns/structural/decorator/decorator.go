@@ -0,0 +1,54 @@
+// Package decorator реализует паттерн "Декоратор" (Decorator).
+//
+// Добавляет напитку новые свойства, оборачивая его в другой напиток, без
+// изменения исходной структуры — через композицию, а не наследование.
+package decorator
+
+// Beverage — напиток с ценой и описанием.
+type Beverage interface {
+	Cost() float64
+	Description() string
+}
+
+// BasicCoffee — обычный кофе без добавок.
+type BasicCoffee struct{}
+
+// Cost реализует Beverage.
+func (b *BasicCoffee) Cost() float64 {
+	return 2.0
+}
+
+// Description реализует Beverage.
+func (b *BasicCoffee) Description() string {
+	return "Простой кофе"
+}
+
+// MilkDecorator добавляет молоко к любому напитку.
+type MilkDecorator struct {
+	Beverage Beverage
+}
+
+// Cost реализует Beverage.
+func (m *MilkDecorator) Cost() float64 {
+	return m.Beverage.Cost() + 0.5
+}
+
+// Description реализует Beverage.
+func (m *MilkDecorator) Description() string {
+	return m.Beverage.Description() + ", с молоком"
+}
+
+// SugarDecorator добавляет сахар к любому напитку.
+type SugarDecorator struct {
+	Beverage Beverage
+}
+
+// Cost реализует Beverage.
+func (s *SugarDecorator) Cost() float64 {
+	return s.Beverage.Cost() + 0.3
+}
+
+// Description реализует Beverage.
+func (s *SugarDecorator) Description() string {
+	return s.Beverage.Description() + ", с сахаром"
+}
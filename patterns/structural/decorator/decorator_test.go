@@ -0,0 +1,34 @@
+package decorator_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/structural/decorator"
+)
+
+func TestDecoratorStacking(t *testing.T) {
+	coffee := &decorator.BasicCoffee{}
+	withMilk := &decorator.MilkDecorator{Beverage: coffee}
+	withMilkAndSugar := &decorator.SugarDecorator{Beverage: withMilk}
+
+	wantDescription := "Простой кофе, с молоком, с сахаром"
+	if got := withMilkAndSugar.Description(); got != wantDescription {
+		t.Errorf("Description() = %q, want %q", got, wantDescription)
+	}
+
+	wantCost := 2.8
+	if got := withMilkAndSugar.Cost(); got != wantCost {
+		t.Errorf("Cost() = %v, want %v", got, wantCost)
+	}
+}
+
+func ExampleMilkDecorator() {
+	coffee := &decorator.BasicCoffee{}
+	coffeeWithMilk := &decorator.MilkDecorator{Beverage: coffee}
+	coffeeWithMilkAndSugar := &decorator.SugarDecorator{Beverage: coffeeWithMilk}
+
+	fmt.Printf("Описание: %s, Цена: %.2f\n", coffeeWithMilkAndSugar.Description(), coffeeWithMilkAndSugar.Cost())
+	// Output:
+	// Описание: Простой кофе, с молоком, с сахаром, Цена: 2.80
+}
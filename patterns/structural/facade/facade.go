@@ -0,0 +1,47 @@
+// Package facade реализует паттерн "Фасад" (Facade).
+//
+// Проблема: клиенту нужно согласованно дёргать несколько подсистем в
+// правильном порядке (прогреть двигатель, проверить топливо, включить
+// зажигание), и знание этого порядка не должно утекать наружу. Решение:
+// один простой метод, скрывающий за собой оркестрацию подсистем.
+package facade
+
+// Engine — подсистема двигателя.
+type Engine struct{}
+
+// Start запускает двигатель.
+func (Engine) Start() string { return "Двигатель запущен" }
+
+// FuelSystem — подсистема топлива.
+type FuelSystem struct{}
+
+// Check проверяет наличие топлива.
+func (FuelSystem) Check() string { return "Топливо в норме" }
+
+// Ignition — подсистема зажигания.
+type Ignition struct{}
+
+// Turn включает зажигание.
+func (Ignition) Turn() string { return "Зажигание включено" }
+
+// CarFacade прячет за одним методом Start последовательность действий,
+// нужных, чтобы завести машину.
+type CarFacade struct {
+	engine   Engine
+	fuel     FuelSystem
+	ignition Ignition
+}
+
+// NewCarFacade создаёт фасад над подсистемами машины.
+func NewCarFacade() *CarFacade {
+	return &CarFacade{}
+}
+
+// Start выполняет все шаги запуска машины в правильном порядке.
+func (c *CarFacade) Start() []string {
+	return []string{
+		c.fuel.Check(),
+		c.ignition.Turn(),
+		c.engine.Start(),
+	}
+}
@@ -0,0 +1,29 @@
+package facade_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/structural/facade"
+)
+
+func TestCarFacadeStartOrder(t *testing.T) {
+	car := facade.NewCarFacade()
+
+	want := []string{"Топливо в норме", "Зажигание включено", "Двигатель запущен"}
+	if got := car.Start(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Start() = %v, want %v", got, want)
+	}
+}
+
+func ExampleCarFacade_Start() {
+	car := facade.NewCarFacade()
+	for _, step := range car.Start() {
+		fmt.Println(step)
+	}
+	// Output:
+	// Топливо в норме
+	// Зажигание включено
+	// Двигатель запущен
+}
@@ -0,0 +1,61 @@
+// Package flyweight реализует паттерн "Приспособленец" (Flyweight).
+//
+// Проблема: миллион деревьев на карте — это миллион копий текстуры и
+// модели, хотя у многих деревьев одного вида эта часть состояния одинакова.
+// Решение: разделить состояние на неизменяемое общее (texture, model —
+// flyweight, переиспользуется) и изменяемое уникальное (x, y — хранится
+// снаружи, в контексте использования).
+package flyweight
+
+import "fmt"
+
+// TreeType — неизменяемое общее состояние, разделяемое между деревьями
+// одного вида.
+type TreeType struct {
+	Name    string
+	Texture string
+}
+
+// Render отрисовывает дерево данного вида в указанных координатах.
+func (t *TreeType) Render(x, y int) string {
+	return fmt.Sprintf("Дерево %q (текстура %s) в точке (%d, %d)", t.Name, t.Texture, x, y)
+}
+
+// TreeFactory выдаёт общие TreeType, создавая каждый вид только один раз.
+type TreeFactory struct {
+	types map[string]*TreeType
+}
+
+// NewTreeFactory создаёт пустую фабрику видов деревьев.
+func NewTreeFactory() *TreeFactory {
+	return &TreeFactory{types: make(map[string]*TreeType)}
+}
+
+// GetTreeType возвращает TreeType для заданного имени и текстуры, создавая
+// его при первом обращении и переиспользуя при последующих.
+func (f *TreeFactory) GetTreeType(name, texture string) *TreeType {
+	key := name + "|" + texture
+	if t, ok := f.types[key]; ok {
+		return t
+	}
+	t := &TreeType{Name: name, Texture: texture}
+	f.types[key] = t
+	return t
+}
+
+// Count возвращает число уникальных TreeType, созданных фабрикой.
+func (f *TreeFactory) Count() int {
+	return len(f.types)
+}
+
+// Tree — уникальное, несовместно разделяемое состояние: только координаты
+// и ссылка на общий TreeType.
+type Tree struct {
+	X, Y int
+	Type *TreeType
+}
+
+// Render рисует дерево, делегируя общее состояние его TreeType.
+func (t *Tree) Render() string {
+	return t.Type.Render(t.X, t.Y)
+}
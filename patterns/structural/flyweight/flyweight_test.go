@@ -0,0 +1,44 @@
+package flyweight_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/structural/flyweight"
+)
+
+func TestTreeFactoryReusesSameType(t *testing.T) {
+	factory := flyweight.NewTreeFactory()
+
+	oak1 := factory.GetTreeType("Дуб", "oak.png")
+	oak2 := factory.GetTreeType("Дуб", "oak.png")
+	pine := factory.GetTreeType("Сосна", "pine.png")
+
+	if oak1 != oak2 {
+		t.Errorf("GetTreeType() returned distinct instances for the same type")
+	}
+	if oak1 == pine {
+		t.Errorf("GetTreeType() returned the same instance for different types")
+	}
+	if got, want := factory.Count(), 2; got != want {
+		t.Errorf("Count() = %d, want %d", got, want)
+	}
+}
+
+func ExampleTreeFactory() {
+	factory := flyweight.NewTreeFactory()
+	oak := factory.GetTreeType("Дуб", "oak.png")
+
+	forest := []*flyweight.Tree{
+		{X: 1, Y: 2, Type: oak},
+		{X: 3, Y: 4, Type: oak},
+	}
+	for _, tree := range forest {
+		fmt.Println(tree.Render())
+	}
+	fmt.Println("Уникальных видов:", factory.Count())
+	// Output:
+	// Дерево "Дуб" (текстура oak.png) в точке (1, 2)
+	// Дерево "Дуб" (текстура oak.png) в точке (3, 4)
+	// Уникальных видов: 1
+}
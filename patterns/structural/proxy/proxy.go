@@ -0,0 +1,51 @@
+// Package proxy реализует паттерн "Прокси" (Proxy).
+//
+// ImageProxy откладывает создание тяжёлого Image до первого обращения
+// (ленивая загрузка) и контролирует доступ к нему.
+package proxy
+
+import "fmt"
+
+// Displayable — то, что умеет показать себя.
+type Displayable interface {
+	Display() string
+}
+
+// Image — "тяжёлый" реальный объект.
+type Image struct {
+	name string
+}
+
+// NewImage создаёт изображение с заданным именем.
+func NewImage(name string) *Image {
+	return &Image{name: name}
+}
+
+// Display реализует Displayable.
+func (i *Image) Display() string {
+	return fmt.Sprintf("Отображение изображения: %s", i.name)
+}
+
+// ImageProxy откладывает загрузку реального Image до первого Display.
+type ImageProxy struct {
+	name      string
+	realImage *Image
+}
+
+// NewImageProxy создаёт прокси для изображения с заданным именем.
+func NewImageProxy(name string) *ImageProxy {
+	return &ImageProxy{name: name}
+}
+
+// Display реализует Displayable, загружая изображение при первом вызове.
+func (p *ImageProxy) Display() string {
+	if p.realImage == nil {
+		p.realImage = NewImage(p.name)
+	}
+	return p.realImage.Display()
+}
+
+// Loaded сообщает, был ли реальный Image уже загружен.
+func (p *ImageProxy) Loaded() bool {
+	return p.realImage != nil
+}
@@ -0,0 +1,34 @@
+package proxy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NayrGosling/NOTES_ON_GOLANG_IN_RUSSIAN/patterns/structural/proxy"
+)
+
+func TestImageProxyLazyLoad(t *testing.T) {
+	p := proxy.NewImageProxy("photo.jpg")
+
+	if p.Loaded() {
+		t.Fatal("Loaded() = true before first Display()")
+	}
+
+	want := "Отображение изображения: photo.jpg"
+	if got := p.Display(); got != want {
+		t.Errorf("Display() = %q, want %q", got, want)
+	}
+	if !p.Loaded() {
+		t.Fatal("Loaded() = false after Display()")
+	}
+}
+
+func ExampleImageProxy() {
+	proxyImage := proxy.NewImageProxy("photo.jpg")
+
+	fmt.Println(proxyImage.Display())
+	fmt.Println(proxyImage.Display())
+	// Output:
+	// Отображение изображения: photo.jpg
+	// Отображение изображения: photo.jpg
+}